@@ -0,0 +1,64 @@
+package errors
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddErrorContext_DoesNotMutateSharedParent(t *testing.T) {
+	parent := AddErrorContext(New("base"), "shared", "parent-value")
+
+	child1 := AddErrorContext(parent, "only-child1", "a")
+	child2 := AddErrorContext(parent, "only-child2", "b")
+
+	// Before the copy-on-write fix, AddErrorContext mutated the map that
+	// parent, child1, and child2 all pointed to, so each child's key would
+	// leak into its siblings and into parent.
+	assert.Equal(t, "a", GetErrorContextValue(child1, "only-child1"))
+	assert.Equal(t, "", GetErrorContextValue(child1, "only-child2"))
+
+	assert.Equal(t, "b", GetErrorContextValue(child2, "only-child2"))
+	assert.Equal(t, "", GetErrorContextValue(child2, "only-child1"))
+
+	assert.Equal(t, "", GetErrorContextValue(parent, "only-child1"))
+	assert.Equal(t, "", GetErrorContextValue(parent, "only-child2"))
+	assert.Equal(t, "parent-value", GetErrorContextValue(parent, "shared"))
+}
+
+type userID int
+
+func TestAddErrorContextValue_TypedRoundTrip(t *testing.T) {
+	err := AddErrorContextValue(New("boom"), "user_id", userID(42))
+
+	id, ok := GetErrorContextValueTyped[userID](err, "user_id")
+	assert.True(t, ok)
+	assert.Equal(t, userID(42), id)
+
+	_, ok = GetErrorContextValueTyped[string](err, "user_id")
+	assert.False(t, ok)
+
+	_, ok = GetErrorContextValueTyped[userID](err, "missing")
+	assert.False(t, ok)
+
+	assert.Equal(t, "42", GetErrorContextValue(err, "user_id"))
+}
+
+func TestAddErrorContextValue_StandardError(t *testing.T) {
+	err := AddErrorContextValue(fmt.Errorf("plain"), "k", 7)
+	v, ok := GetErrorContextValueTyped[int](err, "k")
+	assert.True(t, ok)
+	assert.Equal(t, 7, v)
+}
+
+func TestGetErrorContextValueTyped_MultiError(t *testing.T) {
+	err1 := AddErrorContextValue(New("e1"), "count", 1)
+	err2 := AddErrorContextValue(New("e2"), "count", 2)
+
+	combined := Combine(err1, err2)
+
+	count, ok := GetErrorContextValueTyped[int](combined, "count")
+	assert.True(t, ok)
+	assert.Equal(t, 2, count)
+}
@@ -35,6 +35,42 @@ const (
 	Unauthorized
 )
 
+// errorTypeNames gives the canonical name for each ErrorType, used by String
+// and by structured renderers (JSON, slog).
+var errorTypeNames = map[ErrorType]string{
+	NoType:           "NoType",
+	NotFound:         "NotFound",
+	InvalidParameter: "InvalidParameter",
+	MissingParameter: "MissingParameter",
+	Validation:       "Validation",
+	Forbidden:        "Forbidden",
+	Public:           "Public",
+	BadRequest:       "BadRequest",
+	Unauthorized:     "Unauthorized",
+}
+
+// String returns the canonical name of errorType, e.g. "NotFound".
+func (errorType ErrorType) String() string {
+	if name, ok := errorTypeNames[errorType]; ok {
+		return name
+	}
+
+	return "NoType"
+}
+
+// ParseErrorType returns the ErrorType registered under name (as produced by
+// ErrorType.String), for interop layers (e.g. grpcerr) that need to recover
+// an ErrorType from a wire-transmitted name.
+func ParseErrorType(name string) (ErrorType, bool) {
+	for t, n := range errorTypeNames {
+		if n == name {
+			return t, true
+		}
+	}
+
+	return NoType, false
+}
+
 type customError struct {
 	errorType ErrorType
 	// originalError may be a customError or other error. Storing this is necessary for our Unwrap
@@ -114,7 +150,51 @@ func (e customError) Error() string {
 	return strings.Join(nonEmptyComps, ": ")
 }
 
-type errorContext map[string]string
+// errorContext stores arbitrary typed context values, keyed by name. String
+// values (the common case: pointer/detail/key/failfast, and anything set via
+// AddErrorContext) are the same as before this type held `any`; arbitrary
+// values are set via AddErrorContextValue and read back via
+// GetErrorContextValueTyped.
+type errorContext map[string]any
+
+// cloneContext returns a shallow copy of ctx, so callers can add to it
+// without mutating a map another error still holds a reference to.
+func cloneContext(ctx errorContext) errorContext {
+	cloned := make(errorContext, len(ctx))
+	for k, v := range ctx {
+		cloned[k] = v
+	}
+
+	return cloned
+}
+
+// rawContextOf returns err's raw (typed) context map, for err that is a
+// *customError or *MultiError, and nil otherwise.
+func rawContextOf(err error) errorContext {
+	switch e := err.(type) {
+	case *customError:
+		return e.context
+	case *MultiError:
+		return e.rawContext()
+	default:
+		return nil
+	}
+}
+
+// stringifyContext renders ctx's values via fmt.Sprint, for the string-only
+// GetErrorContext/GetErrorContextValue API.
+func stringifyContext(ctx errorContext) map[string]string {
+	if len(ctx) == 0 {
+		return nil
+	}
+
+	stringified := make(map[string]string, len(ctx))
+	for k, v := range ctx {
+		stringified[k] = fmt.Sprint(v)
+	}
+
+	return stringified
+}
 
 // New creates a new customError
 func (errorType ErrorType) New(msg string) error {
@@ -254,16 +334,15 @@ func Cause(err error) error {
 	return pkgerrors.Cause(err)
 }
 
-// AddErrorContext adds a context to an error
-func AddErrorContext(err error, key, message string) error {
-	var context errorContext
+// AddErrorContextValue adds a typed context value to an error. Unlike the
+// original in-place map mutation this replaced, it never mutates a context
+// map another error still holds a reference to (e.g. a parent customError,
+// or a sibling built from the same parent) — it always clones before
+// writing.
+func AddErrorContextValue(err error, key string, value any) error {
 	if customErr, ok := err.(*customError); ok {
-		context = customErr.context
-		if context == nil {
-			context = make(errorContext)
-		}
-
-		context[key] = message
+		context := cloneContext(customErr.context)
+		context[key] = value
 
 		return &customError{
 			errorType:     customErr.errorType,
@@ -273,40 +352,66 @@ func AddErrorContext(err error, key, message string) error {
 		}
 	}
 
-	context = errorContext{key: message}
-
 	return &customError{
 		errorType:     NoType,
 		originalError: err,
 		pkgError:      err,
-		context:       context,
+		context:       errorContext{key: value},
 	}
 }
 
-// GetErrorContext returns the error context
-func GetErrorContext(err error) map[string]string {
-	if customErr, ok := err.(*customError); ok {
-		return customErr.context
-	}
+// AddErrorContext adds a string context value to an error. See
+// AddErrorContextValue for non-string values.
+func AddErrorContext(err error, key, message string) error {
+	return AddErrorContextValue(err, key, message)
+}
 
-	return nil
+// GetErrorContext returns the error context, with values rendered to strings
+// via fmt.Sprint. See GetErrorContextValueTyped to read back a value's
+// original type.
+func GetErrorContext(err error) map[string]string {
+	return stringifyContext(rawContextOf(err))
 }
 
-// GetErrorContextValue returns an error context value
+// GetErrorContextValue returns an error context value, rendered to a string
+// via fmt.Sprint. See GetErrorContextValueTyped to read back a value's
+// original type.
 func GetErrorContextValue(err error, key string) string {
-	if errContext := GetErrorContext(err); errContext != nil {
-		return errContext[key]
+	if v, ok := rawContextOf(err)[key]; ok {
+		return fmt.Sprint(v)
 	}
 
 	return ""
 }
 
+// GetErrorContextValueTyped returns the context value stored under key,
+// asserted to type T. ok is false if key isn't set, or its value isn't a T.
+func GetErrorContextValueTyped[T any](err error, key string) (T, bool) {
+	var zero T
+
+	v, ok := rawContextOf(err)[key]
+	if !ok {
+		return zero, false
+	}
+
+	typed, ok := v.(T)
+	if !ok {
+		return zero, false
+	}
+
+	return typed, true
+}
+
 // GetType returns the error type
 func GetType(err error) ErrorType {
 	if customErr, ok := err.(*customError); ok {
 		return customErr.errorType
 	}
 
+	if multiErr, ok := err.(*MultiError); ok {
+		return multiErr.GetType()
+	}
+
 	return NoType
 }
 
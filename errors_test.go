@@ -146,8 +146,8 @@ func TestStackTrace(t *testing.T) {
 	err := New("Inner error")
 	wrappedErr := Wrap(err, "Outer error")
 	trace := fmt.Sprintf("%+v", wrappedErr)
-	assert.Contains(t, trace, "gitlab.com/gamestopcorp/platform/blockchain/nft-lib-errors%2egit.New")
-	assert.Contains(t, trace, "gitlab.com/gamestopcorp/platform/blockchain/nft-lib-errors%2egit.Wrapf")
+	assert.Contains(t, trace, "github.com/cyberhorsey/errors.New")
+	assert.Contains(t, trace, "github.com/cyberhorsey/errors.ErrorType.Wrapf")
 }
 
 func TestErrorType_Wrapf(t *testing.T) {
@@ -0,0 +1,236 @@
+// Package grpcerr translates between this repository's *customError type
+// and gRPC statuses, so services can return errors the same way across HTTP
+// and gRPC transports.
+package grpcerr
+
+import (
+	"context"
+
+	cherrors "github.com/cyberhorsey/errors"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/protoadapt"
+)
+
+// errorInfoDomain identifies this package as the source of ErrorInfo
+// details, per the google.rpc.ErrorInfo convention.
+const errorInfoDomain = "cyberhorsey.errors"
+
+// codeByType maps each cherrors.ErrorType to its gRPC code.
+var codeByType = map[cherrors.ErrorType]codes.Code{
+	cherrors.NoType:           codes.Internal,
+	cherrors.NotFound:         codes.NotFound,
+	cherrors.InvalidParameter: codes.InvalidArgument,
+	cherrors.MissingParameter: codes.InvalidArgument,
+	cherrors.Validation:       codes.InvalidArgument,
+	cherrors.Forbidden:        codes.PermissionDenied,
+	cherrors.Public:           codes.Internal,
+	cherrors.BadRequest:       codes.InvalidArgument,
+	cherrors.Unauthorized:     codes.Unauthenticated,
+}
+
+// ToStatus translates err into a gRPC status: its ErrorType becomes the
+// status code, and its Key/Detail/Pointer/context are attached as a
+// google.rpc.ErrorInfo detail (plus a google.rpc.BadRequest.FieldViolation
+// when a Pointer is set).
+func ToStatus(err error) *status.Status {
+	if err == nil {
+		return status.New(codes.OK, "")
+	}
+
+	errType := cherrors.GetType(err)
+
+	code, ok := codeByType[errType]
+	if !ok {
+		code = codes.Internal
+	}
+
+	st := status.New(code, err.Error())
+
+	metadata := map[string]string{"type": errType.String()}
+	for k, v := range cherrors.GetErrorContext(err) {
+		metadata[k] = v
+	}
+
+	details := []proto.Message{
+		&errdetails.ErrorInfo{
+			Reason:   cherrors.Key(err),
+			Domain:   errorInfoDomain,
+			Metadata: metadata,
+		},
+	}
+
+	if pointer := cherrors.Pointer(err); pointer != "" {
+		details = append(details, &errdetails.BadRequest{
+			FieldViolations: []*errdetails.BadRequest_FieldViolation{
+				{Field: pointer, Description: cherrors.Detail(err)},
+			},
+		})
+	}
+
+	detailsV1 := make([]protoadapt.MessageV1, len(details))
+	for i, d := range details {
+		detailsV1[i] = protoadapt.MessageV1Of(d)
+	}
+
+	stWithDetails, detailErr := st.WithDetails(detailsV1...)
+	if detailErr != nil {
+		return st
+	}
+
+	return stWithDetails
+}
+
+// FromStatus translates a gRPC status back into a *customError, round
+// tripping the ErrorType, Key, Detail, Pointer, and context attached by
+// ToStatus.
+func FromStatus(s *status.Status) error {
+	if s == nil || s.Code() == codes.OK {
+		return nil
+	}
+
+	errType := errorTypeForCode(s.Code())
+
+	var key, pointer, detailVal string
+
+	var hasDetail bool
+
+	context := make(map[string]string)
+
+	for _, d := range s.Details() {
+		switch info := d.(type) {
+		case *errdetails.ErrorInfo:
+			key = info.GetReason()
+
+			for k, v := range info.GetMetadata() {
+				switch k {
+				case "type":
+					if t, ok := cherrors.ParseErrorType(v); ok {
+						errType = t
+					}
+				case "detail":
+					detailVal, hasDetail = v, true
+				default:
+					context[k] = v
+				}
+			}
+		case *errdetails.BadRequest:
+			for _, fv := range info.GetFieldViolations() {
+				pointer = fv.GetField()
+			}
+		}
+	}
+
+	var err error
+	if hasDetail {
+		err = errType.NewWithKeyAndDetail(key, detailVal)
+	} else {
+		err = cherrors.WithKey(errType.New(s.Message()), key)
+	}
+
+	if pointer != "" {
+		err = cherrors.WithPointer(err, pointer)
+	}
+
+	for k, v := range context {
+		err = cherrors.AddErrorContext(err, k, v)
+	}
+
+	return err
+}
+
+// errorTypeForCode picks a reasonable ErrorType for a gRPC code that arrived
+// without an ErrorInfo detail (e.g. from a non-cherrors-aware server).
+func errorTypeForCode(code codes.Code) cherrors.ErrorType {
+	switch code {
+	case codes.NotFound:
+		return cherrors.NotFound
+	case codes.InvalidArgument:
+		return cherrors.BadRequest
+	case codes.PermissionDenied:
+		return cherrors.Forbidden
+	case codes.Unauthenticated:
+		return cherrors.Unauthorized
+	default:
+		return cherrors.NoType
+	}
+}
+
+// UnaryServerInterceptor translates any non-nil error a unary handler
+// returns into a gRPC status via ToStatus.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req any,
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (any, error) {
+		resp, err := handler(ctx, req)
+		if err != nil {
+			return resp, ToStatus(err).Err()
+		}
+
+		return resp, nil
+	}
+}
+
+// StreamServerInterceptor is the streaming equivalent of
+// UnaryServerInterceptor.
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := handler(srv, ss); err != nil {
+			return ToStatus(err).Err()
+		}
+
+		return nil
+	}
+}
+
+// UnaryClientInterceptor translates a gRPC status error returned by a unary
+// call back into a *customError via FromStatus.
+func UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(
+		ctx context.Context,
+		method string,
+		req, reply any,
+		cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption,
+	) error {
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		if err == nil {
+			return nil
+		}
+
+		if st, ok := status.FromError(err); ok {
+			return FromStatus(st)
+		}
+
+		return err
+	}
+}
+
+// StreamClientInterceptor is the streaming equivalent of
+// UnaryClientInterceptor.
+func StreamClientInterceptor() grpc.StreamClientInterceptor {
+	return func(
+		ctx context.Context,
+		desc *grpc.StreamDesc,
+		cc *grpc.ClientConn,
+		method string,
+		streamer grpc.Streamer,
+		opts ...grpc.CallOption,
+	) (grpc.ClientStream, error) {
+		cs, err := streamer(ctx, desc, cc, method, opts...)
+		if err != nil {
+			if st, ok := status.FromError(err); ok {
+				return cs, FromStatus(st)
+			}
+		}
+
+		return cs, err
+	}
+}
@@ -0,0 +1,95 @@
+package grpcerr
+
+import (
+	"context"
+	"testing"
+
+	cherrors "github.com/cyberhorsey/errors"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestToStatus_Nil(t *testing.T) {
+	st := ToStatus(nil)
+	assert.Equal(t, codes.OK, st.Code())
+}
+
+func TestToStatus_RoundTrip(t *testing.T) {
+	err := cherrors.WithPointer(
+		cherrors.WithKeyAndDetail(
+			cherrors.NotFound.New("widget not found"),
+			"ERR_WIDGET_NOT_FOUND",
+			"widget 42 does not exist",
+		),
+		"/data/id",
+	)
+	err = cherrors.AddErrorContext(err, "widget_id", "42")
+
+	st := ToStatus(err)
+	assert.Equal(t, codes.NotFound, st.Code())
+
+	gotErr := FromStatus(st)
+	assert.Equal(t, cherrors.NotFound, cherrors.GetType(gotErr))
+	assert.Equal(t, "ERR_WIDGET_NOT_FOUND", cherrors.Key(gotErr))
+	assert.Equal(t, "widget 42 does not exist", cherrors.Detail(gotErr))
+	assert.Equal(t, "/data/id", cherrors.Pointer(gotErr))
+	assert.Equal(t, "42", cherrors.GetErrorContextValue(gotErr, "widget_id"))
+}
+
+func TestToStatus_RoundTrip_NoDetail(t *testing.T) {
+	err := cherrors.WithKey(cherrors.NotFound.New("widget not found"), "ERR_WIDGET_NOT_FOUND")
+
+	st := ToStatus(err)
+	gotErr := FromStatus(st)
+
+	assert.Equal(t, cherrors.NotFound, cherrors.GetType(gotErr))
+	assert.Equal(t, "ERR_WIDGET_NOT_FOUND", cherrors.Key(gotErr))
+	assert.Equal(t, "", cherrors.Detail(gotErr))
+}
+
+func TestFromStatus_OK(t *testing.T) {
+	assert.Nil(t, FromStatus(status.New(codes.OK, "")))
+}
+
+func TestFromStatus_NoDetails(t *testing.T) {
+	st := status.New(codes.PermissionDenied, "nope")
+	err := FromStatus(st)
+	assert.Equal(t, cherrors.Forbidden, cherrors.GetType(err))
+	assert.EqualError(t, err, "nope")
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	interceptor := UnaryServerInterceptor()
+
+	_, err := interceptor(
+		context.Background(),
+		nil,
+		&grpc.UnaryServerInfo{},
+		func(ctx context.Context, req any) (any, error) {
+			return nil, cherrors.Unauthorized.New("no token")
+		},
+	)
+
+	st, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.Unauthenticated, st.Code())
+}
+
+func TestUnaryClientInterceptor(t *testing.T) {
+	interceptor := UnaryClientInterceptor()
+
+	invoker := func(
+		ctx context.Context,
+		method string,
+		req, reply any,
+		cc *grpc.ClientConn,
+		opts ...grpc.CallOption,
+	) error {
+		return ToStatus(cherrors.Validation.New("bad input")).Err()
+	}
+
+	err := interceptor(context.Background(), "/svc/Method", nil, nil, nil, invoker)
+	assert.Equal(t, cherrors.Validation, cherrors.GetType(err))
+}
@@ -0,0 +1,165 @@
+package errors
+
+import (
+	"encoding/json"
+	stderrors "errors"
+	"net/http"
+)
+
+// httpStatusByType maps each ErrorType to its canonical net/http status code.
+var httpStatusByType = map[ErrorType]int{
+	NoType:           http.StatusInternalServerError,
+	NotFound:         http.StatusNotFound,
+	InvalidParameter: http.StatusBadRequest,
+	MissingParameter: http.StatusBadRequest,
+	Validation:       http.StatusUnprocessableEntity,
+	Forbidden:        http.StatusForbidden,
+	Public:           http.StatusInternalServerError,
+	BadRequest:       http.StatusBadRequest,
+	Unauthorized:     http.StatusUnauthorized,
+}
+
+// reservedContextKeys are context keys populated by this package's own
+// helpers (WithKey, WithDetail, WithPointer, WithFailFast, WithRetryable,
+// WithRetryAfter, WithMaxAttempts) and are surfaced through dedicated fields,
+// used internally (e.g. by Retry), or otherwise excluded from the generic
+// meta map so they aren't leaked to HTTP clients.
+var reservedContextKeys = map[string]bool{
+	"key":                 true,
+	"detail":              true,
+	"pointer":             true,
+	"failfast":            true,
+	contextKeyRetryable:   true,
+	contextKeyRetryAfter:  true,
+	contextKeyMaxAttempts: true,
+}
+
+// errorTypeFromChain walks err's wrap chain via stderrors.Unwrap and returns
+// the first non-NoType ErrorType it finds, starting with err itself. This
+// lets HTTPStatus/HTTPInfo see through generic wraps (e.g. fmt.Errorf's %w)
+// that don't carry their own ErrorType.
+func errorTypeFromChain(err error) ErrorType {
+	for e := err; e != nil; e = stderrors.Unwrap(e) {
+		if t := GetType(e); t != NoType {
+			return t
+		}
+	}
+
+	return NoType
+}
+
+// HTTPStatus returns the canonical net/http status code for err, found by
+// walking err's wrap chain for the most specific ErrorType.
+func HTTPStatus(err error) int {
+	if err == nil {
+		return http.StatusOK
+	}
+
+	if status, ok := httpStatusByType[errorTypeFromChain(err)]; ok {
+		return status
+	}
+
+	return http.StatusInternalServerError
+}
+
+// httpErrorSource is the JSON:API "source" member, identifying the part of
+// the request that the error relates to.
+type httpErrorSource struct {
+	Pointer string `json:"pointer,omitempty"`
+}
+
+// httpErrorObject is a single JSON:API error object.
+type httpErrorObject struct {
+	Status string            `json:"status"`
+	Code   string            `json:"code,omitempty"`
+	Detail string            `json:"detail,omitempty"`
+	Source *httpErrorSource  `json:"source,omitempty"`
+	Meta   map[string]string `json:"meta,omitempty"`
+}
+
+// httpErrorBody is the JSON:API-style top level error document.
+type httpErrorBody struct {
+	Errors []httpErrorObject `json:"errors"`
+}
+
+// HTTPInfo translates err into a (status, code, body) triple suitable for
+// writing as an HTTP response: status is the canonical status code, code is
+// the error's Key, and body is a JSON:API-style error document built from the
+// error's Key, Detail, Pointer, and context map.
+//
+// Unless debug is true or err's type is Public, a 5xx status redacts Detail
+// down to a generic "internal error" so internal messages and stack traces
+// aren't leaked to callers.
+func HTTPInfo(err error, debug bool) (status int, code string, body string) {
+	if err == nil {
+		return http.StatusOK, "", ""
+	}
+
+	status = HTTPStatus(err)
+	code = Key(err)
+	detail := Detail(err)
+
+	if detail == "" {
+		detail = err.Error()
+	}
+
+	if !debug && status >= http.StatusInternalServerError && errorTypeFromChain(err) != Public {
+		detail = "internal error"
+	}
+
+	obj := httpErrorObject{
+		Status: http.StatusText(status),
+		Code:   code,
+		Detail: detail,
+	}
+
+	if pointer := Pointer(err); pointer != "" {
+		obj.Source = &httpErrorSource{Pointer: pointer}
+	}
+
+	if ctx := GetErrorContext(err); len(ctx) > 0 {
+		meta := make(map[string]string)
+
+		for k, v := range ctx {
+			if !reservedContextKeys[k] {
+				meta[k] = v
+			}
+		}
+
+		if len(meta) > 0 {
+			obj.Meta = meta
+		}
+	}
+
+	raw, marshalErr := json.Marshal(httpErrorBody{Errors: []httpErrorObject{obj}})
+	if marshalErr != nil {
+		return status, code, `{"errors":[{"status":"Internal Server Error","detail":"internal error"}]}`
+	}
+
+	return status, code, string(raw)
+}
+
+// WriteError writes err to w as a JSON:API-style error response, using
+// HTTPInfo to determine the status code and body.
+func WriteError(w http.ResponseWriter, err error, debug bool) {
+	status, _, body := HTTPInfo(err, debug)
+
+	w.Header().Set("Content-Type", "application/vnd.api+json")
+	w.WriteHeader(status)
+	_, _ = w.Write([]byte(body))
+}
+
+// HandlerFunc is like http.HandlerFunc but may return an error, letting
+// handlers return errors from this package instead of writing responses
+// themselves.
+type HandlerFunc func(w http.ResponseWriter, r *http.Request) error
+
+// Middleware adapts a HandlerFunc into an http.Handler. If h returns a
+// non-nil error, it is written via WriteError instead of being handled by h.
+func Middleware(debug bool, h HandlerFunc) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := h(w, r); err != nil {
+			WriteError(w, err, debug)
+		}
+	})
+}
@@ -0,0 +1,103 @@
+package errors
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHTTPStatus(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want int
+	}{
+		{"nil", nil, http.StatusOK},
+		{"noType", New("boom"), http.StatusInternalServerError},
+		{"notFound", NotFound.New("missing"), http.StatusNotFound},
+		{"badRequest", BadRequest.New("bad"), http.StatusBadRequest},
+		{"validation", Validation.New("invalid"), http.StatusUnprocessableEntity},
+		{"forbidden", Forbidden.New("nope"), http.StatusForbidden},
+		{"unauthorized", Unauthorized.New("nope"), http.StatusUnauthorized},
+		{"standardError", fmt.Errorf("plain"), http.StatusInternalServerError},
+		{
+			"wrappedKeepsInnerType",
+			fmt.Errorf("outer: %w", NotFound.New("missing")),
+			http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		got := HTTPStatus(tt.err)
+		assert.Equal(t, tt.want, got, tt.name)
+	}
+}
+
+func TestHTTPInfo(t *testing.T) {
+	err := WithPointer(
+		WithKeyAndDetail(BadRequest.New("bad field"), "ERR_FIELD", "field is required"),
+		"/data/attributes/name",
+	)
+	err = AddErrorContext(err, "extra", "context")
+
+	status, code, body := HTTPInfo(err, false)
+	assert.Equal(t, http.StatusBadRequest, status)
+	assert.Equal(t, "ERR_FIELD", code)
+
+	var decoded httpErrorBody
+	assert.NoError(t, json.Unmarshal([]byte(body), &decoded))
+	assert.Len(t, decoded.Errors, 1)
+	assert.Equal(t, "field is required", decoded.Errors[0].Detail)
+	assert.Equal(t, "/data/attributes/name", decoded.Errors[0].Source.Pointer)
+	assert.Equal(t, map[string]string{"extra": "context"}, decoded.Errors[0].Meta)
+}
+
+func TestHTTPInfo_RedactsInternalErrorsUnlessDebugOrPublic(t *testing.T) {
+	err := WithDetail(New("db connection string exposed"), "some sensitive detail")
+
+	_, _, body := HTTPInfo(err, false)
+	var decoded httpErrorBody
+	assert.NoError(t, json.Unmarshal([]byte(body), &decoded))
+	assert.Equal(t, "internal error", decoded.Errors[0].Detail)
+
+	_, _, debugBody := HTTPInfo(err, true)
+	assert.NoError(t, json.Unmarshal([]byte(debugBody), &decoded))
+	assert.Equal(t, "some sensitive detail", decoded.Errors[0].Detail)
+
+	publicErr := Public.NewWithDetail("safe to show")
+	_, _, publicBody := HTTPInfo(publicErr, false)
+	assert.NoError(t, json.Unmarshal([]byte(publicBody), &decoded))
+	assert.Equal(t, "safe to show", decoded.Errors[0].Detail)
+}
+
+func TestHTTPInfo_DoesNotLeakRetryMetadata(t *testing.T) {
+	err := WithRetryAfter(WithMaxAttempts(WithRetryable(New("db down"), true), 5), 2*time.Second)
+
+	_, _, body := HTTPInfo(err, false)
+
+	var decoded httpErrorBody
+	assert.NoError(t, json.Unmarshal([]byte(body), &decoded))
+	assert.Equal(t, "internal error", decoded.Errors[0].Detail)
+	assert.Nil(t, decoded.Errors[0].Meta)
+}
+
+func TestMiddleware(t *testing.T) {
+	handler := Middleware(false, func(w http.ResponseWriter, r *http.Request) error {
+		return NotFound.NewWithKeyAndDetail("ERR_NOT_FOUND", "resource not found")
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+
+	var decoded httpErrorBody
+	assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &decoded))
+	assert.Equal(t, "ERR_NOT_FOUND", decoded.Errors[0].Code)
+}
@@ -0,0 +1,201 @@
+package errors
+
+import (
+	"strings"
+	"sync"
+)
+
+// multiErrorSeparator joins child error messages in MultiError.Error().
+const multiErrorSeparator = "; "
+
+// defaultMultiErrorTypePrecedence is the ordering MultiError.GetType uses
+// until SetMultiErrorTypePrecedence overrides it.
+var defaultMultiErrorTypePrecedence = []ErrorType{
+	Validation,
+	BadRequest,
+	NotFound,
+	Unauthorized,
+	Forbidden,
+	InvalidParameter,
+	MissingParameter,
+	Public,
+	NoType,
+}
+
+var (
+	multiErrorPrecedenceMu   sync.RWMutex
+	multiErrorTypePrecedence = defaultMultiErrorTypePrecedence
+)
+
+// SetMultiErrorTypePrecedence overrides the ErrorType ordering (highest
+// precedence first) that MultiError.GetType uses to pick a single
+// representative type across child errors of differing types. Types not
+// listed in order rank below all listed ones. Meant to be called once at
+// startup, like SetMessageResolver; it is safe to call concurrently with
+// MultiError.GetType, but doing so mid-request will affect other in-flight
+// calls to GetType too.
+func SetMultiErrorTypePrecedence(order []ErrorType) {
+	multiErrorPrecedenceMu.Lock()
+	defer multiErrorPrecedenceMu.Unlock()
+
+	multiErrorTypePrecedence = append([]ErrorType(nil), order...)
+}
+
+// typePrecedenceRank returns t's index in the configured
+// multiErrorTypePrecedence (lower is higher precedence), or the length of
+// that ordering if t isn't listed.
+func typePrecedenceRank(t ErrorType) int {
+	multiErrorPrecedenceMu.RLock()
+	defer multiErrorPrecedenceMu.RUnlock()
+
+	for i, pt := range multiErrorTypePrecedence {
+		if pt == t {
+			return i
+		}
+	}
+
+	return len(multiErrorTypePrecedence)
+}
+
+// precedenceLen returns the length of the configured
+// multiErrorTypePrecedence, for initializing GetType's search.
+func precedenceLen() int {
+	multiErrorPrecedenceMu.RLock()
+	defer multiErrorPrecedenceMu.RUnlock()
+
+	return len(multiErrorTypePrecedence)
+}
+
+// MultiError aggregates multiple errors into one. It implements Go 1.20's
+// multi-error Unwrap() []error, so stderrors.Is and stderrors.As traverse
+// every child, and its GetType/GetErrorContext let this package's helpers
+// treat it like a single customError.
+type MultiError struct {
+	errs []error
+}
+
+// Unwrap returns m's children, letting stderrors.Is/As traverse all of them.
+func (m *MultiError) Unwrap() []error {
+	return m.errs
+}
+
+// Error renders m's children's messages joined by a stable separator.
+func (m *MultiError) Error() string {
+	msgs := make([]string, len(m.errs))
+	for i, e := range m.errs {
+		msgs[i] = e.Error()
+	}
+
+	return strings.Join(msgs, multiErrorSeparator)
+}
+
+// Errors returns m's children in order.
+func (m *MultiError) Errors() []error {
+	return m.errs
+}
+
+// GetType returns the highest-precedence ErrorType across m's children,
+// per the configurable ordering installed via SetMultiErrorTypePrecedence
+// (defaulting to Validation > BadRequest > NotFound > ...). Children are
+// inspected via the package-level GetType, so nested MultiErrors are
+// resolved recursively.
+func (m *MultiError) GetType() ErrorType {
+	best := NoType
+	bestRank := precedenceLen() + 1
+
+	for _, e := range m.errs {
+		if rank := typePrecedenceRank(GetType(e)); rank < bestRank {
+			bestRank = rank
+			best = GetType(e)
+		}
+	}
+
+	return best
+}
+
+// rawContext merges m's children's raw (typed) contexts, via rawContextOf.
+// On key conflicts, the later child in m.errs wins.
+func (m *MultiError) rawContext() errorContext {
+	merged := make(errorContext)
+
+	for _, e := range m.errs {
+		for k, v := range rawContextOf(e) {
+			merged[k] = v
+		}
+	}
+
+	if len(merged) == 0 {
+		return nil
+	}
+
+	return merged
+}
+
+// GetErrorContext merges m's children's contexts, with values rendered to
+// strings via fmt.Sprint. On key conflicts, the later child in m.errs wins.
+func (m *MultiError) GetErrorContext() map[string]string {
+	return stringifyContext(m.rawContext())
+}
+
+// Combine returns a MultiError containing every non-nil error in errs, in
+// order. It returns nil if errs has no non-nil errors, and returns that
+// single error unwrapped if exactly one remains. Nested MultiErrors are kept
+// as children rather than flattened; use Flatten to collapse them.
+func Combine(errs ...error) error {
+	var nonNil []error
+
+	for _, e := range errs {
+		if e != nil {
+			nonNil = append(nonNil, e)
+		}
+	}
+
+	switch len(nonNil) {
+	case 0:
+		return nil
+	case 1:
+		return nonNil[0]
+	default:
+		return &MultiError{errs: nonNil}
+	}
+}
+
+// Append returns a MultiError containing err followed by errs, per Combine's
+// rules. It's meant for the common case of accumulating errors in a loop:
+//
+//	var err error
+//	for _, item := range items {
+//		if itemErr := process(item); itemErr != nil {
+//			err = Append(err, itemErr)
+//		}
+//	}
+func Append(err error, errs ...error) error {
+	return Combine(append([]error{err}, errs...)...)
+}
+
+// Flatten collapses nested MultiErrors within err into a single flat
+// MultiError, preserving depth-first order. If err is not a *MultiError, it
+// is returned unchanged.
+func Flatten(err error) error {
+	multiErr, ok := err.(*MultiError)
+	if !ok {
+		return err
+	}
+
+	return Combine(flattenErrs(multiErr.errs)...)
+}
+
+func flattenErrs(errs []error) []error {
+	var flat []error
+
+	for _, e := range errs {
+		if me, ok := e.(*MultiError); ok {
+			flat = append(flat, flattenErrs(me.errs)...)
+			continue
+		}
+
+		flat = append(flat, e)
+	}
+
+	return flat
+}
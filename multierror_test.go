@@ -0,0 +1,95 @@
+package errors
+
+import (
+	stderrors "errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCombine(t *testing.T) {
+	assert.Nil(t, Combine())
+	assert.Nil(t, Combine(nil, nil))
+
+	single := fmt.Errorf("only one")
+	assert.Equal(t, single, Combine(nil, single))
+
+	err1 := fmt.Errorf("err1")
+	err2 := fmt.Errorf("err2")
+	combined := Combine(err1, nil, err2)
+
+	multiErr, ok := combined.(*MultiError)
+	assert.True(t, ok)
+	assert.Equal(t, []error{err1, err2}, multiErr.Errors())
+	assert.Equal(t, "err1; err2", combined.Error())
+}
+
+func TestAppend(t *testing.T) {
+	var err error
+	err = Append(err, fmt.Errorf("first"))
+	err = Append(err, fmt.Errorf("second"))
+
+	multiErr, ok := err.(*MultiError)
+	assert.True(t, ok)
+	assert.Len(t, multiErr.Errors(), 2)
+	assert.Equal(t, "first; second", err.Error())
+}
+
+func TestMultiError_Is(t *testing.T) {
+	sentinel := fmt.Errorf("sentinel")
+	wrapped := fmt.Errorf("wrapped: %w", sentinel)
+	combined := Combine(fmt.Errorf("unrelated"), wrapped)
+
+	assert.True(t, stderrors.Is(combined, sentinel))
+	assert.True(t, stderrors.Is(combined, wrapped))
+	assert.False(t, stderrors.Is(combined, fmt.Errorf("not present")))
+}
+
+func TestMultiError_GetType(t *testing.T) {
+	combined := Combine(NotFound.New("missing"), Validation.New("invalid"))
+	assert.Equal(t, Validation, GetType(combined))
+
+	combined = Combine(NotFound.New("missing"), New("no type"))
+	assert.Equal(t, NotFound, GetType(combined))
+}
+
+func TestSetMultiErrorTypePrecedence(t *testing.T) {
+	t.Cleanup(func() {
+		SetMultiErrorTypePrecedence(defaultMultiErrorTypePrecedence)
+	})
+
+	SetMultiErrorTypePrecedence([]ErrorType{NotFound, Validation})
+
+	combined := Combine(Validation.New("invalid"), NotFound.New("missing"))
+	assert.Equal(t, NotFound, GetType(combined))
+}
+
+func TestMultiError_GetErrorContext(t *testing.T) {
+	err1 := AddErrorContext(BadRequest.New("e1"), "field", "name")
+	err2 := AddErrorContext(Validation.New("e2"), "field", "email")
+	err2 = AddErrorContext(err2, "other", "value")
+
+	combined := Combine(err1, err2)
+
+	assert.Equal(
+		t,
+		map[string]string{"field": "email", "other": "value"},
+		GetErrorContext(combined),
+	)
+}
+
+func TestFlatten(t *testing.T) {
+	err1 := fmt.Errorf("err1")
+	err2 := fmt.Errorf("err2")
+	err3 := fmt.Errorf("err3")
+
+	nested := Combine(Combine(err1, err2), err3)
+	flat := Flatten(nested)
+
+	multiErr, ok := flat.(*MultiError)
+	assert.True(t, ok)
+	assert.Equal(t, []error{err1, err2, err3}, multiErr.Errors())
+
+	assert.Equal(t, err1, Flatten(err1))
+}
@@ -0,0 +1,169 @@
+package errors
+
+import (
+	"context"
+	"strconv"
+	"time"
+)
+
+const (
+	contextKeyRetryable   = "retryable"
+	contextKeyRetryAfter  = "retry_after"
+	contextKeyMaxAttempts = "max_attempts"
+)
+
+// nonRetryableTypes are ErrorTypes that Retry treats as non-retryable
+// regardless of any retry metadata attached to the error, since retrying
+// them can never succeed without external intervention.
+var nonRetryableTypes = map[ErrorType]bool{
+	Unauthorized:     true,
+	Forbidden:        true,
+	InvalidParameter: true,
+}
+
+// WithRetryable marks err's retryability explicitly: true marks it safe to
+// retry, false marks it as never retryable. Retry honors an explicit false
+// by stopping immediately, the same as IsFailFast or a non-retryable
+// ErrorType.
+func WithRetryable(err error, retryable bool) error {
+	return AddErrorContext(err, contextKeyRetryable, strconv.FormatBool(retryable))
+}
+
+// WithRetryAfter attaches a recommended backoff duration to err, for callers
+// (or Retry) to wait before trying again.
+func WithRetryAfter(err error, after time.Duration) error {
+	return AddErrorContext(err, contextKeyRetryAfter, after.String())
+}
+
+// WithMaxAttempts attaches a recommended attempt ceiling to err.
+func WithMaxAttempts(err error, maxAttempts int) error {
+	return AddErrorContext(err, contextKeyMaxAttempts, strconv.Itoa(maxAttempts))
+}
+
+// RetryPolicy reads back the retry metadata attached to err via
+// WithRetryable, WithRetryAfter, and WithMaxAttempts. ok is false if none of
+// them were ever called on err, in which case the other return values are
+// zero values and should not be relied on.
+func RetryPolicy(err error) (retryable bool, after time.Duration, maxAttempts int, ok bool) {
+	ctx := GetErrorContext(err)
+
+	retryableStr, hasRetryable := ctx[contextKeyRetryable]
+	afterStr, hasAfter := ctx[contextKeyRetryAfter]
+	maxAttemptsStr, hasMaxAttempts := ctx[contextKeyMaxAttempts]
+
+	if !hasRetryable && !hasAfter && !hasMaxAttempts {
+		return false, 0, 0, false
+	}
+
+	retryable, _ = strconv.ParseBool(retryableStr)
+	after, _ = time.ParseDuration(afterStr)
+	maxAttempts, _ = strconv.Atoi(maxAttemptsStr)
+
+	return retryable, after, maxAttempts, true
+}
+
+// explicitRetryable reports whether err's ErrorContext carries an explicit
+// WithRetryable marker, and if so, what it was set to. Unlike RetryPolicy's
+// ok, which is also true when only RetryAfter or MaxAttempts were set, this
+// distinguishes "never told us" from "told us false".
+func explicitRetryable(err error) (retryable bool, hasRetryable bool) {
+	retryableStr, hasRetryable := GetErrorContext(err)[contextKeyRetryable]
+	if !hasRetryable {
+		return false, false
+	}
+
+	retryable, _ = strconv.ParseBool(retryableStr)
+
+	return retryable, true
+}
+
+// retryOptions configures Retry.
+type retryOptions struct {
+	maxAttempts int
+	backoff     time.Duration
+}
+
+// RetryOption configures Retry.
+type RetryOption func(*retryOptions)
+
+// WithRetryMaxAttempts caps the number of attempts Retry makes. It's
+// overridden per-call by a MaxAttempts set on the returned error via
+// WithMaxAttempts. Defaults to 3.
+func WithRetryMaxAttempts(maxAttempts int) RetryOption {
+	return func(o *retryOptions) {
+		o.maxAttempts = maxAttempts
+	}
+}
+
+// WithRetryBackoff sets the default wait between attempts, used when the
+// returned error doesn't specify its own via WithRetryAfter. Defaults to 0
+// (no wait).
+func WithRetryBackoff(backoff time.Duration) RetryOption {
+	return func(o *retryOptions) {
+		o.backoff = backoff
+	}
+}
+
+// Retry calls fn until it succeeds, ctx is done, or the error's retry policy
+// says to stop: fn's error is fail fast (IsFailFast), explicitly marked
+// non-retryable (WithRetryable(err, false)), has a non-retryable ErrorType
+// (Unauthorized, Forbidden, InvalidParameter), or the attempt ceiling is
+// reached. Between attempts it waits for the error's RetryAfter if set, or
+// the configured backoff otherwise. This is meant for HTTP/gRPC clients
+// that want retry behavior driven by the errors their calls return, rather
+// than a fixed policy.
+func Retry(ctx context.Context, fn func() error, opts ...RetryOption) error {
+	options := retryOptions{maxAttempts: 3}
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	var lastErr error
+
+	for attempt := 1; ; attempt++ {
+		lastErr = fn()
+		if lastErr == nil {
+			return nil
+		}
+
+		if IsFailFast(lastErr) || nonRetryableTypes[errorTypeFromChain(lastErr)] {
+			return lastErr
+		}
+
+		if retryable, ok := explicitRetryable(lastErr); ok && !retryable {
+			return lastErr
+		}
+
+		maxAttempts := options.maxAttempts
+		if _, _, policyMax, ok := RetryPolicy(lastErr); ok && policyMax > 0 {
+			maxAttempts = policyMax
+		}
+
+		if attempt >= maxAttempts {
+			return lastErr
+		}
+
+		wait := options.backoff
+		if _, after, _, ok := RetryPolicy(lastErr); ok && after > 0 {
+			wait = after
+		}
+
+		if wait <= 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			default:
+				continue
+			}
+		}
+
+		timer := time.NewTimer(wait)
+
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
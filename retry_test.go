@@ -0,0 +1,96 @@
+package errors
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetryPolicy(t *testing.T) {
+	_, _, _, ok := RetryPolicy(fmt.Errorf("plain"))
+	assert.False(t, ok)
+
+	err := WithMaxAttempts(WithRetryAfter(WithRetryable(New("boom"), true), 2*time.Second), 5)
+
+	retryable, after, maxAttempts, ok := RetryPolicy(err)
+	assert.True(t, ok)
+	assert.True(t, retryable)
+	assert.Equal(t, 2*time.Second, after)
+	assert.Equal(t, 5, maxAttempts)
+}
+
+func TestRetry_SucceedsEventually(t *testing.T) {
+	attempts := 0
+	err := Retry(context.Background(), func() error {
+		attempts++
+		if attempts < 3 {
+			return WithRetryable(New("transient"), true)
+		}
+
+		return nil
+	}, WithRetryMaxAttempts(5))
+
+	assert.NoError(t, err)
+	assert.Equal(t, 3, attempts)
+}
+
+func TestRetry_StopsOnFailFast(t *testing.T) {
+	attempts := 0
+	err := Retry(context.Background(), func() error {
+		attempts++
+		return WithFailFast(New("permanent"))
+	}, WithRetryMaxAttempts(5))
+
+	assert.Error(t, err)
+	assert.Equal(t, 1, attempts)
+}
+
+func TestRetry_StopsOnExplicitlyNonRetryable(t *testing.T) {
+	attempts := 0
+	err := Retry(context.Background(), func() error {
+		attempts++
+		return WithRetryable(New("won't help"), false)
+	}, WithRetryMaxAttempts(5))
+
+	assert.Error(t, err)
+	assert.Equal(t, 1, attempts)
+}
+
+func TestRetry_StopsOnNonRetryableType(t *testing.T) {
+	attempts := 0
+	err := Retry(context.Background(), func() error {
+		attempts++
+		return Unauthorized.New("nope")
+	}, WithRetryMaxAttempts(5))
+
+	assert.Error(t, err)
+	assert.Equal(t, 1, attempts)
+}
+
+func TestRetry_RespectsMaxAttempts(t *testing.T) {
+	attempts := 0
+	err := Retry(context.Background(), func() error {
+		attempts++
+		return New("always fails")
+	}, WithRetryMaxAttempts(2))
+
+	assert.Error(t, err)
+	assert.Equal(t, 2, attempts)
+}
+
+func TestRetry_StopsOnContextCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	attempts := 0
+	err := Retry(ctx, func() error {
+		attempts++
+		return WithRetryAfter(New("slow"), time.Second)
+	}, WithRetryMaxAttempts(5))
+
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, 1, attempts)
+}
@@ -0,0 +1,155 @@
+package errors
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	pkgerrors "github.com/pkg/errors"
+)
+
+// DefaultLocale is the locale SentinelError.New renders messages in, and the
+// locale NewContext falls back to when ctx doesn't carry one via WithLocale.
+const DefaultLocale = "en"
+
+// localeContextKey is the context.Context key WithLocale/LocaleFromContext
+// store a locale under.
+type localeContextKey struct{}
+
+// WithLocale returns a copy of ctx carrying locale, for a later
+// SentinelError.NewContext call to render messages in. Locale is per
+// request, carried through ctx, rather than a shared package-level
+// setting, since a shared setting would let one goroutine's locale change
+// leak into every other in-flight request's error messages.
+func WithLocale(ctx context.Context, locale string) context.Context {
+	return context.WithValue(ctx, localeContextKey{}, locale)
+}
+
+// LocaleFromContext returns the locale embedded in ctx via WithLocale, or
+// DefaultLocale if ctx doesn't carry one.
+func LocaleFromContext(ctx context.Context) string {
+	if locale, ok := ctx.Value(localeContextKey{}).(string); ok {
+		return locale
+	}
+
+	return DefaultLocale
+}
+
+// SentinelError is a registered, package-level error value. It can be used
+// directly with stderrors.Is, and instantiated per call via New or
+// NewContext, which preserve the sentinel's identity through Is while
+// formatting a call specific detail message.
+type SentinelError interface {
+	error
+	// Key returns the key this sentinel was registered under.
+	Key() string
+	// Type returns the ErrorType this sentinel was registered with.
+	Type() ErrorType
+	// New creates an error carrying this sentinel's key and type, with
+	// detail rendered in DefaultLocale from this sentinel's default message
+	// (or the installed message resolver) and args. stderrors.Is(result,
+	// sentinel) is true for the SentinelError this was created from.
+	New(args ...any) error
+	// NewContext is like New, but renders in the locale carried by ctx (see
+	// WithLocale), falling back to DefaultLocale if ctx carries none.
+	NewContext(ctx context.Context, args ...any) error
+}
+
+// sentinelError is the concrete, unexported SentinelError implementation.
+// Its identity (pointer equality) is what stderrors.Is keys off of, so
+// sentinels must only ever be created via Register.
+type sentinelError struct {
+	key            string
+	errorType      ErrorType
+	defaultMessage string
+}
+
+func (s *sentinelError) Error() string   { return s.defaultMessage }
+func (s *sentinelError) Key() string     { return s.key }
+func (s *sentinelError) Type() ErrorType { return s.errorType }
+
+func (s *sentinelError) New(args ...any) error {
+	return s.newWithLocale(DefaultLocale, args...)
+}
+
+func (s *sentinelError) NewContext(ctx context.Context, args ...any) error {
+	return s.newWithLocale(LocaleFromContext(ctx), args...)
+}
+
+func (s *sentinelError) newWithLocale(locale string, args ...any) error {
+	detail := s.render(locale, args...)
+
+	base := &customError{
+		errorType:     s.errorType,
+		originalError: s,
+		pkgError:      pkgerrors.New(detail),
+	}
+
+	return WithKeyAndDetail(base, s.key, detail)
+}
+
+// render formats s's detail message for locale, preferring the installed
+// message resolver over s.defaultMessage when one is set.
+func (s *sentinelError) render(locale string, args ...any) string {
+	resolverMu.RLock()
+	resolve := resolver
+	resolverMu.RUnlock()
+
+	if resolve != nil {
+		return resolve(s.key, locale, args...)
+	}
+
+	if len(args) == 0 {
+		return s.defaultMessage
+	}
+
+	return fmt.Sprintf(s.defaultMessage, args...)
+}
+
+var (
+	registryMu sync.RWMutex
+	registry   = make(map[string]*sentinelError)
+
+	resolverMu sync.RWMutex
+	resolver   func(key, locale string, args ...any) string
+)
+
+// Register creates and registers a SentinelError under key, with errorType
+// and defaultMessage (a fmt.Sprintf format string, used when no message
+// resolver is installed). Registering the same key twice panics, since
+// sentinels are meant to be declared once, typically in a package var block.
+func Register(key string, errorType ErrorType, defaultMessage string) SentinelError {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	if _, exists := registry[key]; exists {
+		panic(fmt.Sprintf("errors: sentinel %q already registered", key))
+	}
+
+	s := &sentinelError{key: key, errorType: errorType, defaultMessage: defaultMessage}
+	registry[key] = s
+
+	return s
+}
+
+// Lookup returns the SentinelError registered under key, if any.
+func Lookup(key string) (SentinelError, bool) {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+
+	s, ok := registry[key]
+
+	return s, ok
+}
+
+// SetMessageResolver installs a translator hook that SentinelError.New and
+// NewContext use in place of the sentinel's defaultMessage, called with the
+// sentinel's key, the locale (DefaultLocale for New, or the one carried by
+// ctx for NewContext), and the args passed in. Passing nil reverts to each
+// sentinel's defaultMessage.
+func SetMessageResolver(resolve func(key, locale string, args ...any) string) {
+	resolverMu.Lock()
+	defer resolverMu.Unlock()
+
+	resolver = resolve
+}
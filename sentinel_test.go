@@ -0,0 +1,81 @@
+package errors
+
+import (
+	"context"
+	stderrors "errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRegisterAndLookup(t *testing.T) {
+	sentinel := Register("ERR_TEST_SENTINEL_LOOKUP", NotFound, "resource %s not found")
+
+	got, ok := Lookup("ERR_TEST_SENTINEL_LOOKUP")
+	assert.True(t, ok)
+	assert.Equal(t, sentinel, got)
+
+	_, ok = Lookup("ERR_TEST_SENTINEL_MISSING")
+	assert.False(t, ok)
+}
+
+func TestRegister_PanicsOnDuplicateKey(t *testing.T) {
+	Register("ERR_TEST_SENTINEL_DUP", NoType, "dup")
+
+	assert.Panics(t, func() {
+		Register("ERR_TEST_SENTINEL_DUP", NoType, "dup")
+	})
+}
+
+func TestSentinelError_New(t *testing.T) {
+	sentinel := Register("ERR_TEST_SENTINEL_NEW", Validation, "field %s is invalid")
+
+	err := sentinel.New("email")
+
+	assert.True(t, stderrors.Is(err, sentinel))
+	assert.Equal(t, Validation, GetType(err))
+	assert.Equal(t, "ERR_TEST_SENTINEL_NEW", Key(err))
+	assert.Equal(t, "field email is invalid", Detail(err))
+
+	wrapped := Wrap(err, "outer")
+	assert.True(t, stderrors.Is(wrapped, sentinel))
+}
+
+func TestSentinelError_MessageResolver(t *testing.T) {
+	sentinel := Register("ERR_TEST_SENTINEL_I18N", Validation, "field %s is invalid")
+
+	t.Cleanup(func() {
+		SetMessageResolver(nil)
+	})
+
+	SetMessageResolver(func(key, locale string, args ...any) string {
+		if key == "ERR_TEST_SENTINEL_I18N" && locale == "fr" {
+			return "le champ est invalide"
+		}
+
+		return "unresolved"
+	})
+
+	ctx := WithLocale(context.Background(), "fr")
+	err := sentinel.NewContext(ctx, "email")
+	assert.Equal(t, "le champ est invalide", Detail(err))
+}
+
+func TestSentinelError_NewContext_DefaultsLocale(t *testing.T) {
+	sentinel := Register("ERR_TEST_SENTINEL_I18N_DEFAULT", Validation, "field %s is invalid")
+
+	t.Cleanup(func() {
+		SetMessageResolver(nil)
+	})
+
+	SetMessageResolver(func(key, locale string, args ...any) string {
+		if locale == DefaultLocale {
+			return "resolved in default locale"
+		}
+
+		return "unresolved"
+	})
+
+	err := sentinel.NewContext(context.Background(), "email")
+	assert.Equal(t, "resolved in default locale", Detail(err))
+}
@@ -0,0 +1,205 @@
+package errors
+
+import (
+	"encoding/json"
+	stderrors "errors"
+	"log/slog"
+	"runtime"
+
+	pkgerrors "github.com/pkg/errors"
+)
+
+// stackTracer is implemented by github.com/pkg/errors errors that carry a
+// captured stack trace.
+type stackTracer interface {
+	StackTrace() pkgerrors.StackTrace
+}
+
+// jsonFrame is a single stack frame, resolved from a github.com/pkg/errors
+// program counter via runtime.CallersFrames.
+type jsonFrame struct {
+	Func string `json:"func"`
+	File string `json:"file"`
+	Line int    `json:"line"`
+}
+
+// jsonChainLink describes one error in the chain beneath a customError.
+type jsonChainLink struct {
+	Message string `json:"message"`
+	Type    string `json:"type,omitempty"`
+}
+
+// jsonError is the structured representation of a customError, used by both
+// MarshalJSON and LogValue.
+type jsonError struct {
+	Type     string            `json:"type"`
+	Key      string            `json:"key,omitempty"`
+	Detail   string            `json:"detail,omitempty"`
+	Pointer  string            `json:"pointer,omitempty"`
+	FailFast bool              `json:"failfast,omitempty"`
+	Context  map[string]string `json:"context,omitempty"`
+	Chain    []jsonChainLink   `json:"chain,omitempty"`
+	Stack    []jsonFrame       `json:"stack,omitempty"`
+}
+
+// frames resolves e's own stack trace (captured at the point e was created
+// or wrapped) into {func, file, line} frames.
+func (e *customError) frames() []jsonFrame {
+	tracer, ok := e.pkgError.(stackTracer)
+	if !ok {
+		return nil
+	}
+
+	st := tracer.StackTrace()
+	frames := make([]jsonFrame, 0, len(st))
+
+	for _, f := range st {
+		rf, _ := runtime.CallersFrames([]uintptr{uintptr(f) - 1}).Next()
+		frames = append(frames, jsonFrame{Func: rf.Function, File: rf.File, Line: rf.Line})
+	}
+
+	return frames
+}
+
+// chain walks e.originalError, describing each wrapped error's message and
+// ErrorType (empty for errors that aren't a customError).
+func (e *customError) chain() []jsonChainLink {
+	var links []jsonChainLink
+
+	for cur := stderrors.Unwrap(error(e)); cur != nil; cur = stderrors.Unwrap(cur) {
+		link := jsonChainLink{Message: cur.Error()}
+		if _, ok := cur.(*customError); ok {
+			link.Type = GetType(cur).String()
+		}
+
+		links = append(links, link)
+	}
+
+	return links
+}
+
+// toJSONError builds e's structured representation.
+func (e *customError) toJSONError() jsonError {
+	return jsonError{
+		Type:     e.errorType.String(),
+		Key:      Key(e),
+		Detail:   Detail(e),
+		Pointer:  Pointer(e),
+		FailFast: IsFailFast(e),
+		Context:  GetErrorContext(e),
+		Chain:    e.chain(),
+		Stack:    e.frames(),
+	}
+}
+
+// MarshalJSON renders e as a structured JSON record containing its type,
+// key, detail, pointer, failfast flag, context map, wrapped chain, and stack
+// trace, so log pipelines can index on fields instead of scraping Error().
+func (e *customError) MarshalJSON() ([]byte, error) {
+	return json.Marshal(e.toJSONError())
+}
+
+// LogValue implements slog.LogValuer, rendering e as a structured group with
+// the same fields as MarshalJSON, plus a "message" field holding e.Error().
+func (e *customError) LogValue() slog.Value {
+	j := e.toJSONError()
+
+	attrs := []slog.Attr{
+		slog.String("type", j.Type),
+		slog.String("message", e.Error()),
+	}
+
+	if j.Key != "" {
+		attrs = append(attrs, slog.String("key", j.Key))
+	}
+
+	if j.Detail != "" {
+		attrs = append(attrs, slog.String("detail", j.Detail))
+	}
+
+	if j.Pointer != "" {
+		attrs = append(attrs, slog.String("pointer", j.Pointer))
+	}
+
+	if j.FailFast {
+		attrs = append(attrs, slog.Bool("failfast", j.FailFast))
+	}
+
+	if len(j.Context) > 0 {
+		ctxAttrs := make([]any, 0, len(j.Context)*2)
+		for k, v := range j.Context {
+			ctxAttrs = append(ctxAttrs, k, v)
+		}
+
+		attrs = append(attrs, slog.Group("context", ctxAttrs...))
+	}
+
+	if len(j.Chain) > 0 {
+		chainValues := make([]any, len(j.Chain))
+		for i, link := range j.Chain {
+			chainValues[i] = slog.GroupValue(
+				slog.String("message", link.Message),
+				slog.String("type", link.Type),
+			)
+		}
+
+		attrs = append(attrs, slog.Any("chain", chainValues))
+	}
+
+	if len(j.Stack) > 0 {
+		attrs = append(attrs, slog.Any("stack", j.Stack))
+	}
+
+	return slog.GroupValue(attrs...)
+}
+
+// Fields flattens err into a map suitable for structured loggers such as zap
+// or logrus that accept key/value pairs rather than a slog.Value. Non-custom
+// errors are rendered as {"error": err.Error()}.
+func Fields(err error) map[string]any {
+	customErr, ok := err.(*customError)
+	if !ok {
+		if err == nil {
+			return map[string]any{}
+		}
+
+		return map[string]any{"error": err.Error()}
+	}
+
+	j := customErr.toJSONError()
+
+	fields := map[string]any{
+		"error": customErr.Error(),
+		"type":  j.Type,
+	}
+
+	if j.Key != "" {
+		fields["key"] = j.Key
+	}
+
+	if j.Detail != "" {
+		fields["detail"] = j.Detail
+	}
+
+	if j.Pointer != "" {
+		fields["pointer"] = j.Pointer
+	}
+
+	if j.FailFast {
+		fields["failfast"] = j.FailFast
+	}
+
+	if len(j.Context) > 0 {
+		fields["context"] = j.Context
+	}
+
+	if len(j.Chain) > 0 {
+		fields["chain"] = j.Chain
+	}
+
+	if len(j.Stack) > 0 {
+		fields["stack"] = j.Stack
+	}
+
+	return fields
+}
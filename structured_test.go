@@ -0,0 +1,78 @@
+package errors
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMarshalJSON(t *testing.T) {
+	err := WithKeyAndDetail(
+		Wrap(BadRequest.New("inner error"), "outer error"),
+		"ERR_KEY",
+		"human detail",
+	)
+
+	raw, marshalErr := json.Marshal(err)
+	assert.NoError(t, marshalErr)
+
+	var decoded jsonError
+	assert.NoError(t, json.Unmarshal(raw, &decoded))
+
+	assert.Equal(t, "BadRequest", decoded.Type)
+	assert.Equal(t, "ERR_KEY", decoded.Key)
+	assert.Equal(t, "human detail", decoded.Detail)
+	assert.NotEmpty(t, decoded.Chain)
+	assert.Equal(t, "BadRequest", decoded.Chain[0].Type)
+	assert.NotEmpty(t, decoded.Stack)
+
+	var sawTestFrame bool
+
+	for _, f := range decoded.Stack {
+		if f.Func == "github.com/cyberhorsey/errors.TestMarshalJSON" {
+			sawTestFrame = true
+		}
+	}
+
+	assert.True(t, sawTestFrame)
+}
+
+func TestLogValue(t *testing.T) {
+	err := BadRequest.NewWithKeyAndDetail("ERR_KEY", "bad input")
+	customErr := err.(*customError)
+
+	v := customErr.LogValue()
+	group := v.Group()
+
+	var sawType, sawKey bool
+
+	for _, attr := range group {
+		if attr.Key == "type" {
+			sawType = true
+			assert.Equal(t, "BadRequest", attr.Value.String())
+		}
+
+		if attr.Key == "key" {
+			sawKey = true
+			assert.Equal(t, "ERR_KEY", attr.Value.String())
+		}
+	}
+
+	assert.True(t, sawType)
+	assert.True(t, sawKey)
+}
+
+func TestFields(t *testing.T) {
+	err := WithDetail(NotFound.New("missing"), "resource missing")
+	fields := Fields(err)
+
+	assert.Equal(t, "NotFound", fields["type"])
+	assert.Equal(t, "resource missing", fields["detail"])
+	assert.NotEmpty(t, fields["stack"])
+}
+
+func TestFields_standardError(t *testing.T) {
+	assert.Equal(t, map[string]any{"error": "plain"}, Fields(New("plain").(*customError).pkgError))
+	assert.Equal(t, map[string]any{}, Fields(nil))
+}